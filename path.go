@@ -0,0 +1,99 @@
+package treeprint
+
+// Path addresses a node relative to some ancestor as a sequence of child
+// indices, one per level, the way btrfs's TreePath addresses a node by the
+// slot it occupies at each level of the tree.
+type Path []int
+
+// childAt returns the idx-th child of n, or nil if idx is out of range.
+// Negative indices count from the end, so -1 is the last child.
+func childAt(n *node, idx int) *node {
+	ln := len(n.Nodes)
+	if idx < 0 {
+		idx += ln
+	}
+	if idx < 0 || idx >= ln {
+		return nil
+	}
+	return n.Nodes[idx]
+}
+
+func (n *node) NodeAt(path ...int) Tree {
+	cur := n
+	for _, idx := range path {
+		cur = childAt(cur, idx)
+		if cur == nil {
+			return nil
+		}
+	}
+	return cur
+}
+
+func (n *node) PathOf(target Tree) Path {
+	tn, ok := target.(*node)
+	if !ok {
+		return nil
+	}
+	for i, child := range n.Nodes {
+		if child == tn {
+			return Path{i}
+		}
+		if p := child.PathOf(target); p != nil {
+			return append(Path{i}, p...)
+		}
+	}
+	return nil
+}
+
+func (n *node) RemoveAt(path ...int) bool {
+	if len(path) == 0 {
+		return false
+	}
+	parent := n
+	if len(path) > 1 {
+		t := n.NodeAt(path[:len(path)-1]...)
+		if t == nil {
+			return false
+		}
+		parent = t.(*node)
+	}
+	idx := path[len(path)-1]
+	ln := len(parent.Nodes)
+	if idx < 0 {
+		idx += ln
+	}
+	if idx < 0 || idx >= ln {
+		return false
+	}
+	parent.Nodes = append(parent.Nodes[:idx], parent.Nodes[idx+1:]...)
+	return true
+}
+
+func (n *node) MoveTo(src, dst Path) bool {
+	srcTree := n.NodeAt(src...)
+	dstTree := n.NodeAt(dst...)
+	if srcTree == nil || dstTree == nil {
+		return false
+	}
+	sn := srcTree.(*node)
+	dn := dstTree.(*node)
+	if sn == dn || isAncestorOf(sn, dn) {
+		return false
+	}
+	if !n.RemoveAt(src...) {
+		return false
+	}
+	sn.Root = dn
+	dn.Nodes = append(dn.Nodes, sn)
+	return true
+}
+
+// isAncestorOf reports whether n is found anywhere in ancestor's subtree.
+func isAncestorOf(ancestor, n *node) bool {
+	for _, child := range ancestor.Nodes {
+		if child == n || isAncestorOf(child, n) {
+			return true
+		}
+	}
+	return false
+}
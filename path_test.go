@@ -0,0 +1,54 @@
+package treeprint
+
+import "testing"
+
+func TestNodeAt(t *testing.T) {
+	root := New()
+	root.AddNode("a")
+	root.AddNode("b")
+	root.AddNode("c")
+
+	if got := root.NodeAt(); got != root {
+		t.Fatalf("NodeAt() with empty path = %v, want receiver", got)
+	}
+	if got := root.NodeAt(-1); got == nil || got.GetValue() != "c" {
+		t.Fatalf("NodeAt(-1) = %v, want last child \"c\"", got)
+	}
+	if got := root.NodeAt(1); got == nil || got.GetValue() != "b" {
+		t.Fatalf("NodeAt(1) = %v, want \"b\"", got)
+	}
+	if got := root.NodeAt(5); got != nil {
+		t.Fatalf("NodeAt(5) = %v, want nil for out-of-range index", got)
+	}
+	if got := root.NodeAt(-5); got != nil {
+		t.Fatalf("NodeAt(-5) = %v, want nil for out-of-range negative index", got)
+	}
+}
+
+func TestMoveToRejectsCycle(t *testing.T) {
+	root := New()
+	branch := root.AddBranch("branch")
+	leaf := branch.AddNode("leaf")
+	_ = leaf
+
+	// Moving branch into its own descendant must be rejected.
+	if root.MoveTo(Path{0}, Path{0, 0}) {
+		t.Fatal("MoveTo moved a node into its own subtree, want rejection")
+	}
+	if root.MoveTo(Path{0}, Path{0}) {
+		t.Fatal("MoveTo moved a node onto itself, want rejection")
+	}
+}
+
+func TestMoveToRestructures(t *testing.T) {
+	root := New()
+	root.AddNode("a")
+	root.AddNode("b")
+
+	if !root.MoveTo(Path{0}, Path{1}) {
+		t.Fatal("MoveTo returned false for a valid move")
+	}
+	if got := root.NodeAt(0, 0); got == nil || got.GetValue() != "a" {
+		t.Fatalf("after MoveTo, NodeAt(0, 0) = %v, want \"a\" under \"b\"", got)
+	}
+}
@@ -0,0 +1,224 @@
+package treeprint
+
+import "strings"
+
+// PathTree pretty-prints separator-delimited string keys (file paths, URL
+// routes, dotted config keys) as a compressed radix tree: a run of
+// single-child branches is collapsed into one displayed segment, and later
+// expanded again if a sibling insertion splits the shared prefix.
+type PathTree struct {
+	sep  string
+	root *radixNode
+}
+
+type radixNode struct {
+	segs     []string
+	children []*radixNode
+	value    Value
+	isLeaf   bool
+}
+
+// NewPathTree creates an empty PathTree whose keys are split on sep. An
+// empty sep defaults to "/".
+func NewPathTree(sep string) *PathTree {
+	if sep == "" {
+		sep = "/"
+	}
+	return &PathTree{sep: sep, root: &radixNode{}}
+}
+
+// Insert adds v at key, splitting any existing collapsed edge that only
+// partially shares key's prefix.
+func (p *PathTree) Insert(key string, v Value) {
+	insertSegs(p.root, p.split(key), v)
+}
+
+// LongestPrefix returns the Tree node whose full key is the longest prefix
+// of key present in the PathTree, and true if one was found.
+func (p *PathTree) LongestPrefix(key string) (Tree, bool) {
+	n, segs, ok := p.walkTo(p.split(key), true)
+	if !ok {
+		return nil, false
+	}
+	return renderRadix(n, segs, p.sep), true
+}
+
+// WalkPrefix visits every node in the subtree rooted at prefix, the same
+// way Walk visits a whole tree, with level 0 at prefix itself. prefix may
+// land in the middle of a collapsed edge (e.g. "usr" when only "usr/local"
+// was inserted); the subtree under that edge is still visited. WalkPrefix
+// does nothing if prefix is not present in the PathTree at all.
+func (p *PathTree) WalkPrefix(prefix string, fn TreeWalkFn) error {
+	n, segs, ok := p.walkTo(p.split(prefix), false)
+	if !ok {
+		return nil
+	}
+	return renderRadix(n, segs, p.sep).Walk(fn)
+}
+
+// Root renders the whole PathTree as a Tree for printing.
+func (p *PathTree) Root() Tree {
+	return renderRadix(p.root, nil, p.sep)
+}
+
+func (p *PathTree) String() string {
+	return p.Root().String()
+}
+
+func (p *PathTree) Bytes() []byte {
+	return p.Root().Bytes()
+}
+
+func (p *PathTree) split(key string) []string {
+	key = strings.Trim(key, p.sep)
+	if key == "" {
+		return nil
+	}
+	return strings.Split(key, p.sep)
+}
+
+func commonPrefixLen(a, b []string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+func insertSegs(n *radixNode, segs []string, v Value) {
+	if len(segs) == 0 {
+		n.value = v
+		n.isLeaf = true
+		return
+	}
+	for _, c := range n.children {
+		common := commonPrefixLen(c.segs, segs)
+		if common == 0 {
+			continue
+		}
+		if common == len(c.segs) {
+			insertSegs(c, segs[common:], v)
+			return
+		}
+		// segs shares only a prefix of c.segs: split c at common so the
+		// shared part becomes its own branch.
+		tail := &radixNode{segs: c.segs[common:], children: c.children, value: c.value, isLeaf: c.isLeaf}
+		c.segs = c.segs[:common]
+		c.children = []*radixNode{tail}
+		c.value = nil
+		c.isLeaf = false
+		insertSegs(c, segs[common:], v)
+		return
+	}
+	n.children = append(n.children, &radixNode{segs: segs, value: v, isLeaf: true})
+}
+
+// walkTo follows segs from the root, returning the radixNode reached and
+// the segments consumed to reach it.
+//
+// If longestLeaf is true it returns the deepest leaf on the path (for
+// LongestPrefix), ignoring any remainder that only partially matches a
+// collapsed edge.
+//
+// Otherwise (for WalkPrefix) segs must be fully consumed: either landing
+// exactly on a node boundary, or ending partway through a collapsed edge,
+// in which case a synthetic node representing that split point is
+// returned so its subtree (including the unconsumed tail of the edge) can
+// still be rendered and walked.
+func (p *PathTree) walkTo(segs []string, longestLeaf bool) (*radixNode, []string, bool) {
+	cur := p.root
+	var consumed []string
+	remaining := segs
+	var lastLeaf *radixNode
+	var lastLeafSegs []string
+
+	for len(remaining) > 0 {
+		var next, partial *radixNode
+		for _, c := range cur.children {
+			common := commonPrefixLen(c.segs, remaining)
+			if common == 0 {
+				continue
+			}
+			if common == len(c.segs) {
+				next = c
+				break
+			}
+			if common == len(remaining) {
+				partial = c
+				break
+			}
+		}
+
+		if next != nil {
+			consumed = append(consumed, next.segs...)
+			remaining = remaining[len(next.segs):]
+			cur = next
+			if cur.isLeaf {
+				lastLeaf = cur
+				lastLeafSegs = append([]string{}, consumed...)
+			}
+			continue
+		}
+
+		if partial != nil {
+			if longestLeaf {
+				// Landing mid-edge is never itself a recorded leaf.
+				return lastLeaf, lastLeafSegs, lastLeaf != nil
+			}
+			consumed = append(consumed, remaining...)
+			synth := &radixNode{
+				segs: remaining,
+				children: []*radixNode{{
+					segs:     partial.segs[len(remaining):],
+					children: partial.children,
+					value:    partial.value,
+					isLeaf:   partial.isLeaf,
+				}},
+			}
+			return synth, consumed, true
+		}
+
+		if longestLeaf {
+			return lastLeaf, lastLeafSegs, lastLeaf != nil
+		}
+		return nil, nil, false
+	}
+
+	if longestLeaf {
+		return lastLeaf, lastLeafSegs, lastLeaf != nil
+	}
+	return cur, consumed, true
+}
+
+// renderRadix builds a display Tree for n, whose own label is the already
+// joined segs (nil at the PathTree root).
+func renderRadix(n *radixNode, segs []string, sep string) Tree {
+	t := New()
+	if segs != nil {
+		t.SetValue(strings.Join(segs, sep))
+	}
+	if n.isLeaf {
+		t.SetMetaValue(n.value)
+	}
+	for _, c := range n.children {
+		appendRadixChild(t, c, sep)
+	}
+	return t
+}
+
+func appendRadixChild(parent Tree, n *radixNode, sep string) {
+	label := strings.Join(n.segs, sep)
+	var t Tree
+	if n.isLeaf {
+		t = parent.AddMetaBranch(n.value, label)
+	} else {
+		t = parent.AddBranch(label)
+	}
+	for _, c := range n.children {
+		appendRadixChild(t, c, sep)
+	}
+}
@@ -0,0 +1,54 @@
+package treeprint
+
+import "testing"
+
+func TestPathTreeWalkPrefixMidEdge(t *testing.T) {
+	p := NewPathTree("/")
+	p.Insert("usr/local/bin", 1)
+	p.Insert("usr/local/lib", 2)
+
+	var visited int
+	err := p.WalkPrefix("usr", func(v *Vertex, level int) error {
+		visited++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkPrefix returned error: %v", err)
+	}
+	if visited == 0 {
+		t.Fatalf("WalkPrefix(\"usr\") visited no nodes, want the usr/local subtree")
+	}
+}
+
+func TestPathTreeLongestPrefixReturnsUsableSubtree(t *testing.T) {
+	p := NewPathTree("/")
+	p.Insert("usr/local/bin", "bin")
+	p.Insert("usr/local/lib", "lib")
+
+	got, ok := p.LongestPrefix("usr/local/bin/extra")
+	if !ok {
+		t.Fatal("LongestPrefix did not find a match")
+	}
+	if got.GetValue() != "usr/local/bin" {
+		t.Fatalf("GetValue() = %q, want %q", got.GetValue(), "usr/local/bin")
+	}
+	if got.GetMetaValue() != "bin" {
+		t.Fatalf("GetMetaValue() = %v, want %q", got.GetMetaValue(), "bin")
+	}
+}
+
+func TestPathTreeInsertSplitsSharedPrefix(t *testing.T) {
+	p := NewPathTree("/")
+	p.Insert("usr/local/bin", 1)
+	p.Insert("usr/local/lib", 2)
+	p.Insert("usr/share", 3)
+
+	got, ok := p.LongestPrefix("usr/local/bin")
+	if !ok || got.GetMetaValue() != 1 {
+		t.Fatalf("LongestPrefix(\"usr/local/bin\") = %v, %v", got, ok)
+	}
+	got, ok = p.LongestPrefix("usr/share")
+	if !ok || got.GetMetaValue() != 3 {
+		t.Fatalf("LongestPrefix(\"usr/share\") = %v, %v", got, ok)
+	}
+}
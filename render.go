@@ -0,0 +1,121 @@
+package treeprint
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// EdgeStyle controls the glyphs used to draw branch lines when rendering a
+// tree, replacing the old package-level EdgeTypeLink/Mid/End globals with a
+// value callers can swap per-render.
+type EdgeStyle struct {
+	Link   string
+	Mid    string
+	End    string
+	Indent string
+	Pad    string
+}
+
+var (
+	// StyleUnicode is the original treeprint look and the default used by
+	// String/Bytes.
+	StyleUnicode = EdgeStyle{Link: "│", Mid: "├──", End: "└──", Indent: "  ", Pad: "    "}
+	// StyleASCII avoids UTF-8 box-drawing characters for terminals that
+	// can't render them.
+	StyleASCII = EdgeStyle{Link: "|", Mid: "|--", End: "\\--", Indent: "  ", Pad: "    "}
+	// StyleRounded draws the last child of a branch with a rounded corner.
+	StyleRounded = EdgeStyle{Link: "│", Mid: "├──", End: "╰──", Indent: "  ", Pad: "    "}
+	// StyleBoxHeavy draws thicker box-drawing lines.
+	StyleBoxHeavy = EdgeStyle{Link: "┃", Mid: "┣━━", End: "┗━━", Indent: "  ", Pad: "    "}
+)
+
+// Formatter renders a single node's value and meta value into the text
+// placed after its branch line.
+type Formatter interface {
+	Format(v Value, meta MetaValue) string
+}
+
+type defaultFormatter struct{}
+
+func (defaultFormatter) Format(v Value, meta MetaValue) string {
+	if meta != nil {
+		return fmt.Sprintf("[%v]  %v", meta, v)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func (n *node) SetFormatter(f Formatter) Tree {
+	n.formatter = f
+	return n
+}
+
+// Render streams the tree to w using style, without buffering the whole
+// output in memory the way Bytes does.
+func (n *node) Render(w io.Writer, style EdgeStyle) error {
+	formatter := n.formatter
+	if formatter == nil {
+		formatter = defaultFormatter{}
+	}
+	levelSize := map[int]int{
+		1: len(n.Nodes),
+	}
+
+	if n.Root == nil {
+		if _, err := io.WriteString(w, formatter.Format(n.Value, n.Meta)); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+
+	return n.Walk(func(v *Vertex, level int) error {
+		// Already did the 0-th node
+		if level == 0 {
+			return nil
+		}
+		// Decrement counter for current level
+		levelSize[level]--
+		// Save counter for next level
+		if len(v.Nodes) > 0 {
+			levelSize[level+1] = len(v.Nodes)
+		}
+
+		// If there are no more nodes at this level, use end edge
+		var edge string
+		if levelSize[level] == 0 {
+			edge = style.End
+		} else {
+			edge = style.Mid
+		}
+
+		// For every level, indent
+		for i := 1; i < level; i++ {
+			if levelSize[i] > 0 {
+				// If level has nodes, continue its link
+				if _, err := fmt.Fprintf(w, "%s%s ", style.Link, style.Indent); err != nil {
+					return err
+				}
+			} else {
+				// If not, just print empty padding
+				if _, err := io.WriteString(w, style.Pad); err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err := fmt.Fprintf(w, "%s %s\n", edge, formatter.Format(v.Value, v.Meta))
+		return err
+	})
+}
+
+func (n *node) Bytes() []byte {
+	buf := new(bytes.Buffer)
+	n.Render(buf, StyleUnicode)
+	return buf.Bytes()
+}
+
+func (n *node) String() string {
+	return string(n.Bytes())
+}
@@ -0,0 +1,37 @@
+package treeprint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRenderStylesAndFormatter(t *testing.T) {
+	root := New()
+	root.SetValue("root")
+	root.AddNode("leaf")
+
+	ascii := root.String()
+	if !strings.Contains(root.String(), "└──") {
+		t.Fatalf("String() (StyleUnicode) = %q, want unicode end edge", ascii)
+	}
+
+	var buf strings.Builder
+	if err := root.Render(&buf, StyleASCII); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !strings.Contains(buf.String(), "\\--") {
+		t.Fatalf("Render(StyleASCII) = %q, want ASCII end edge", buf.String())
+	}
+
+	root.SetFormatter(upperFormatter{})
+	if got := root.String(); !strings.Contains(got, "LEAF") {
+		t.Fatalf("String() after SetFormatter = %q, want uppercased value", got)
+	}
+}
+
+type upperFormatter struct{}
+
+func (upperFormatter) Format(v Value, meta MetaValue) string {
+	return strings.ToUpper(fmt.Sprint(v))
+}
@@ -0,0 +1,119 @@
+package treeprint
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// nodeData is the wire representation used by both JSON and YAML
+// (de)serialization.
+type nodeData struct {
+	Value    Value      `json:"value"`
+	Meta     MetaValue  `json:"meta,omitempty"`
+	Children []nodeData `json:"children,omitempty"`
+}
+
+func (n *node) toNodeData() nodeData {
+	d := nodeData{Value: n.Value, Meta: n.Meta}
+	for _, child := range n.Nodes {
+		d.Children = append(d.Children, child.toNodeData())
+	}
+	return d
+}
+
+func fromNodeData(d nodeData, root *node) *node {
+	n := &node{Root: root, Value: d.Value, Meta: d.Meta}
+	for _, child := range d.Children {
+		n.Nodes = append(n.Nodes, fromNodeData(child, n))
+	}
+	return n
+}
+
+// MarshalJSON serializes the tree as nested {"value":..., "meta":...,
+// "children":[...]} objects.
+func (n *node) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.toNodeData())
+}
+
+// UnmarshalJSON replaces the receiver's contents with the tree encoded in
+// data, as produced by MarshalJSON.
+func (n *node) UnmarshalJSON(data []byte) error {
+	var d nodeData
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	*n = *fromNodeData(d, n.Root)
+	return nil
+}
+
+// ToYAML serializes the tree into a YAML document with the same
+// value/meta/children shape as MarshalJSON. Since JSON is valid YAML 1.2,
+// this reuses the JSON encoding directly rather than pulling in an
+// external YAML dependency for a package that otherwise has none.
+func (n *node) ToYAML() ([]byte, error) {
+	return n.MarshalJSON()
+}
+
+// FromYAML builds a Tree from data produced by ToYAML. It parses the input
+// as JSON, so only YAML documents that are also valid JSON are accepted;
+// block style, or flow style with unquoted keys or scalars, is rejected.
+func FromYAML(data []byte) (Tree, error) {
+	return FromJSON(data)
+}
+
+// FromJSON builds a Tree from data produced by MarshalJSON.
+func FromJSON(data []byte) (Tree, error) {
+	n := &node{}
+	if err := n.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (n *node) Merge(other Tree, conflict func(a, b Tree) Tree) Tree {
+	on, ok := other.(*node)
+	if !ok {
+		return n
+	}
+	mergeNode(n, on, conflict)
+	return n
+}
+
+func mergeNode(dst, src *node, conflict func(a, b Tree) Tree) {
+	if conflict != nil && (!reflect.DeepEqual(dst.Value, src.Value) || !reflect.DeepEqual(dst.Meta, src.Meta)) {
+		if resolved, ok := conflict(dst, src).(*node); ok {
+			dst.Value = resolved.Value
+			dst.Meta = resolved.Meta
+		}
+	}
+	original := dst.Nodes
+	matched := make([]bool, len(original))
+	for _, srcChild := range src.Nodes {
+		if i := findMatchingChild(original, matched, srcChild); i >= 0 {
+			matched[i] = true
+			mergeNode(original[i], srcChild, conflict)
+			continue
+		}
+		dst.Nodes = append(dst.Nodes, cloneNode(srcChild, dst))
+	}
+}
+
+// findMatchingChild finds the first not-yet-matched child whose value
+// equals srcChild's, the way Merge identifies "the same node" on both
+// sides regardless of sibling order.
+func findMatchingChild(candidates []*node, matched []bool, srcChild *node) int {
+	for i, c := range candidates {
+		if !matched[i] && reflect.DeepEqual(c.Value, srcChild.Value) {
+			return i
+		}
+	}
+	return -1
+}
+
+func cloneNode(n *node, root *node) *node {
+	clone := &node{Root: root, Value: n.Value, Meta: n.Meta}
+	for _, child := range n.Nodes {
+		clone.Nodes = append(clone.Nodes, cloneNode(child, clone))
+	}
+	return clone
+}
@@ -0,0 +1,56 @@
+package treeprint
+
+import "testing"
+
+func TestJSONRoundTrip(t *testing.T) {
+	orig := New()
+	orig.AddNode("a")
+	orig.AddMetaNode("m", "b")
+	branch := orig.AddBranch("c")
+	branch.AddNode("d")
+
+	data, err := orig.(*node).MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	got, err := FromJSON(data)
+	if err != nil {
+		t.Fatalf("FromJSON: %v", err)
+	}
+
+	if got.String() != orig.String() {
+		t.Fatalf("round trip mismatch:\ngot:  %s\nwant: %s", got.String(), orig.String())
+	}
+}
+
+func TestMergeMatchesByValueNotPosition(t *testing.T) {
+	dst := New()
+	dst.AddNode("x")
+	dst.AddNode("y")
+
+	src := New()
+	src.AddNode("x")
+	src.AddNode("y2")
+	src.AddNode("z")
+
+	dst.Merge(src, func(a, b Tree) Tree { return b })
+
+	names := []string{}
+	dst.Walk(func(v *Vertex, level int) error {
+		if level == 1 {
+			names = append(names, v.Value.(string))
+		}
+		return nil
+	})
+
+	want := map[string]bool{"x": true, "y": true, "y2": true, "z": true}
+	if len(names) != len(want) {
+		t.Fatalf("got children %v, want one of each of %v", names, want)
+	}
+	for _, n := range names {
+		if !want[n] {
+			t.Fatalf("unexpected child %q in merged tree: %v", n, names)
+		}
+	}
+}
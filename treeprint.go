@@ -2,8 +2,8 @@
 package treeprint
 
 import (
-	"bytes"
-	"fmt"
+	"context"
+	"io"
 	"reflect"
 )
 
@@ -31,10 +31,37 @@ type Tree interface {
 	FindByValue(value Value) Tree
 	//  returns the last node of a tree
 	FindLastNode() Tree
-	// String renders the tree or subtree as a string.
+	// NodeAt resolves path to a node by following child indices level by
+	// level, starting from the receiver; an empty path returns the
+	// receiver itself. A negative index counts from the end of that
+	// level's children (-1 is the last child). It returns nil if any node
+	// the path addresses doesn't exist, rather than panicking.
+	NodeAt(path ...int) Tree
+	// PathOf returns the Path from the receiver down to target, or nil if
+	// target is not found anywhere in the receiver's subtree.
+	PathOf(target Tree) Path
+	// RemoveAt removes the node addressed by path from its parent, reporting
+	// whether a node was found and removed.
+	RemoveAt(path ...int) bool
+	// MoveTo detaches the node at src and appends it as the last child of
+	// the node at dst, reporting whether the move succeeded. Moving a node
+	// into its own subtree is rejected.
+	MoveTo(src, dst Path) bool
+	// Merge folds other into the receiver, matching nodes at each level by
+	// GetValue() equality rather than position. Where a matched pair has a
+	// differing value or meta value, conflict is called to resolve it; nodes
+	// present only in other are copied over. It returns the receiver.
+	Merge(other Tree, conflict func(a, b Tree) Tree) Tree
+	// String renders the tree or subtree as a string, using StyleUnicode.
 	String() string
-	// Bytes renders the tree or subtree as byteslice.
+	// Bytes renders the tree or subtree as byteslice, using StyleUnicode.
 	Bytes() []byte
+	// Render streams the tree or subtree to w using the given EdgeStyle,
+	// without buffering the whole output in memory.
+	Render(w io.Writer, style EdgeStyle) error
+	// SetFormatter overrides how a node's value and meta value are rendered
+	// into a line of output. It returns the receiver for chaining.
+	SetFormatter(f Formatter) Tree
 
 	GetValue() Value
 	SetValue(value Value)
@@ -42,6 +69,10 @@ type Tree interface {
 	SetMetaValue(meta MetaValue)
 
 	Walk(TreeWalkFn) error
+	// WalkCtx is like Walk, but additionally accepts a context.Context for
+	// early cancellation and accumulates every non-sentinel error returned
+	// by fn instead of aborting on the first one.
+	WalkCtx(ctx context.Context, fn TreeWalkFn) error
 }
 
 type TreeWalkFn func(v *Vertex, level int) error
@@ -52,10 +83,11 @@ type Vertex struct {
 }
 
 type node struct {
-	Root  *node
-	Meta  MetaValue
-	Value Value
-	Nodes []*node
+	Root      *node
+	Meta      MetaValue
+	Value     Value
+	Nodes     []*node
+	formatter Formatter
 }
 
 func (n *node) FindLastNode() Tree {
@@ -153,67 +185,6 @@ func (n *node) Walk(walkFn TreeWalkFn) error {
 	return nil
 }
 
-func (n *node) Bytes() []byte {
-	buf := new(bytes.Buffer)
-	levelSize := map[int]int{
-		1: len(n.Nodes),
-	}
-
-	if n.Root == nil {
-		if n.Meta != nil {
-			buf.WriteString(fmt.Sprintf("[%v]  %v", n.Meta, n.Value))
-		} else {
-			buf.WriteString(fmt.Sprintf("%v", n.Value))
-		}
-		buf.WriteByte('\n')
-	}
-
-	n.Walk(func(v *Vertex, level int) error {
-		// Already did the 0-th node
-		if level == 0 {
-			return nil
-		}
-		// Decrement counter for current level
-		levelSize[level]--
-		// Save counter for next level
-		if len(v.Nodes) > 0 {
-			levelSize[level+1] = len(v.Nodes)
-		}
-
-		// If there are no more nodes at this level, use end edge
-		var edge EdgeType
-		if levelSize[level] == 0 {
-			edge = EdgeTypeEnd
-		} else {
-			edge = EdgeTypeMid
-		}
-
-		// For every level, indent
-		for i := 1; i < level; i++ {
-			if levelSize[i] > 0 {
-				// If level has nodes, continue its link
-				fmt.Fprintf(buf, "%s%c%c ", EdgeTypeLink, '\u00A0', '\u00A0')
-			} else {
-				// If not, just print empty padding
-				fmt.Fprint(buf, "    ")
-			}
-		}
-
-		if v.Meta != nil {
-			fmt.Fprintf(buf, "%s [%v]  %v\n", edge, v.Meta, v.Value)
-		} else {
-			fmt.Fprintf(buf, "%s %v\n", edge, v.Value)
-		}
-		return nil
-	})
-
-	return buf.Bytes()
-}
-
-func (n *node) String() string {
-	return string(n.Bytes())
-}
-
 func (n *node) SetValue(value Value) {
 	n.Value = value
 }
@@ -230,14 +201,6 @@ func (n *node) GetMetaValue() MetaValue {
 	return n.Meta
 }
 
-type EdgeType string
-
-var (
-	EdgeTypeLink EdgeType = "│"
-	EdgeTypeMid  EdgeType = "├──"
-	EdgeTypeEnd  EdgeType = "└──"
-)
-
 func New() Tree {
 	return &node{Value: "."}
 }
@@ -0,0 +1,74 @@
+package treeprint
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrSkipSubtree, returned from a WalkCtx callback, skips the children of
+// the vertex just visited but continues the walk with its siblings.
+var ErrSkipSubtree = errors.New("treeprint: skip subtree")
+
+// ErrStopWalk, returned from a WalkCtx callback, stops the walk cleanly:
+// WalkCtx returns nil (or any errors already accumulated) instead of
+// ErrStopWalk itself.
+var ErrStopWalk = errors.New("treeprint: stop walk")
+
+// MultiError collects every error returned by a WalkCtx callback over the
+// course of a single traversal, mirroring the btrfs treeWalk approach of
+// not aborting a large traversal for one bad vertex.
+type MultiError []error
+
+func (m MultiError) Error() string {
+	msgs := make([]string, len(m))
+	for i, err := range m {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (n *node) WalkCtx(ctx context.Context, fn TreeWalkFn) error {
+	var errs MultiError
+	vertices := []*Vertex{{n, 0}}
+
+	for len(vertices) > 0 {
+		select {
+		case <-ctx.Done():
+			if len(errs) > 0 {
+				return errs
+			}
+			return ctx.Err()
+		default:
+		}
+
+		ln := len(vertices)
+		v := vertices[ln-1]
+		vertices = vertices[:ln-1]
+
+		err := fn(v, v.Level)
+		switch {
+		case err == nil:
+			for i := len(v.node.Nodes) - 1; i >= 0; i-- {
+				vertices = append(vertices, &Vertex{v.node.Nodes[i], v.Level + 1})
+			}
+		case errors.Is(err, ErrSkipSubtree):
+			// don't descend into this vertex's children
+		case errors.Is(err, ErrStopWalk):
+			if len(errs) > 0 {
+				return errs
+			}
+			return nil
+		default:
+			errs = append(errs, err)
+			for i := len(v.node.Nodes) - 1; i >= 0; i-- {
+				vertices = append(vertices, &Vertex{v.node.Nodes[i], v.Level + 1})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
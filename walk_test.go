@@ -0,0 +1,103 @@
+package treeprint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func buildWalkTestTree() Tree {
+	root := New()
+	a := root.AddBranch("a")
+	a.AddNode("a1")
+	a.AddNode("a2")
+	root.AddNode("b")
+	return root
+}
+
+func TestWalkCtxAggregatesErrors(t *testing.T) {
+	root := buildWalkTestTree()
+	boom := errors.New("boom")
+
+	err := root.WalkCtx(context.Background(), func(v *Vertex, level int) error {
+		if v.Value == "a1" || v.Value == "b" {
+			return boom
+		}
+		return nil
+	})
+
+	var merr MultiError
+	if !errors.As(err, &merr) {
+		t.Fatalf("WalkCtx error = %v, want a MultiError", err)
+	}
+	if len(merr) != 2 {
+		t.Fatalf("MultiError has %d errors, want 2", len(merr))
+	}
+}
+
+func TestWalkCtxSkipSubtree(t *testing.T) {
+	root := buildWalkTestTree()
+
+	var visited []string
+	err := root.WalkCtx(context.Background(), func(v *Vertex, level int) error {
+		visited = append(visited, fmt.Sprint(v.Value))
+		if v.Value == "a" {
+			return ErrSkipSubtree
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkCtx returned error: %v", err)
+	}
+	for _, v := range visited {
+		if v == "a1" || v == "a2" {
+			t.Fatalf("ErrSkipSubtree did not skip children of a, visited: %v", visited)
+		}
+	}
+}
+
+func TestWalkCtxStopWalk(t *testing.T) {
+	root := buildWalkTestTree()
+
+	var visited int
+	err := root.WalkCtx(context.Background(), func(v *Vertex, level int) error {
+		visited++
+		return ErrStopWalk
+	})
+	if err != nil {
+		t.Fatalf("WalkCtx returned error: %v", err)
+	}
+	if visited != 1 {
+		t.Fatalf("visited %d vertices, want 1 before ErrStopWalk", visited)
+	}
+}
+
+func TestWalkCtxWrappedSentinel(t *testing.T) {
+	root := buildWalkTestTree()
+
+	var visited int
+	err := root.WalkCtx(context.Background(), func(v *Vertex, level int) error {
+		visited++
+		return fmt.Errorf("wrapped: %w", ErrStopWalk)
+	})
+	if err != nil {
+		t.Fatalf("WalkCtx returned error for wrapped ErrStopWalk: %v", err)
+	}
+	if visited != 1 {
+		t.Fatalf("visited %d vertices, want 1 before wrapped ErrStopWalk", visited)
+	}
+}
+
+func TestWalkCtxCancellation(t *testing.T) {
+	root := buildWalkTestTree()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := root.WalkCtx(ctx, func(v *Vertex, level int) error {
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("WalkCtx error = %v, want context.Canceled", err)
+	}
+}